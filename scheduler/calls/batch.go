@@ -0,0 +1,181 @@
+package calls
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/mesos/mesos-go"
+	"github.com/mesos/mesos-go/scheduler"
+)
+
+// CallBatcher accumulates Accept/Decline offer decisions made across
+// goroutines and flushes them as a minimal set of scheduler Calls: one
+// merged ACCEPT per distinct agentID passed to Accept, and one bulk DECLINE
+// per distinct Filters value. It guarantees that any given OfferID appears
+// in at most one outgoing call. Use this instead of sending one Call per
+// decision when a scheduler fields many small offers per second.
+type CallBatcher struct {
+	caller Caller
+	window time.Duration
+
+	mu       sync.Mutex
+	accepts  map[string]*acceptBatch
+	declines map[string]*declineBatch
+	timer    *time.Timer
+}
+
+type acceptBatch struct {
+	operations map[mesos.OfferID][]mesos.Offer_Operation
+}
+
+type declineBatch struct {
+	filters  *mesos.Filters
+	offerIDs map[mesos.OfferID]struct{}
+}
+
+// NewCallBatcher returns a CallBatcher that flushes accumulated decisions to
+// caller at most once per window.
+func NewCallBatcher(caller Caller, window time.Duration) *CallBatcher {
+	return &CallBatcher{
+		caller:   caller,
+		window:   window,
+		accepts:  make(map[string]*acceptBatch),
+		declines: make(map[string]*declineBatch),
+	}
+}
+
+// Accept schedules offerID, which the master offered against agentID, to be
+// accepted with the given operations. Offers for the same agentID are
+// merged into a single ACCEPT call; offers for different agents are split
+// across calls, since a single ACCEPT's offer IDs must all belong to one
+// agent.
+func (b *CallBatcher) Accept(offerID mesos.OfferID, agentID mesos.AgentID, ops ...OperationBuilder) {
+	built := make([]mesos.Offer_Operation, 0, len(ops))
+	for _, op := range ops {
+		built = append(built, op())
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.purgeLocked(offerID)
+
+	key := agentID.Value
+	ab := b.accepts[key]
+	if ab == nil {
+		ab = &acceptBatch{operations: make(map[mesos.OfferID][]mesos.Offer_Operation)}
+		b.accepts[key] = ab
+	}
+	ab.operations[offerID] = built
+	b.scheduleFlushLocked()
+}
+
+// Decline schedules offerID to be declined with the given filters. Offers
+// sharing an equivalent Filters value are merged into a single DECLINE
+// call.
+func (b *CallBatcher) Decline(offerID mesos.OfferID, filters *mesos.Filters) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.purgeLocked(offerID)
+
+	key := filtersKey(filters)
+	db := b.declines[key]
+	if db == nil {
+		db = &declineBatch{filters: filters, offerIDs: make(map[mesos.OfferID]struct{})}
+		b.declines[key] = db
+	}
+	db.offerIDs[offerID] = struct{}{}
+	b.scheduleFlushLocked()
+}
+
+// purgeLocked drops offerID from any batch it's currently part of, so that a
+// caller who changes their mind about an offer doesn't cause it to appear in
+// two outgoing calls.
+func (b *CallBatcher) purgeLocked(offerID mesos.OfferID) {
+	for _, ab := range b.accepts {
+		delete(ab.operations, offerID)
+	}
+	for _, db := range b.declines {
+		delete(db.offerIDs, offerID)
+	}
+}
+
+func (b *CallBatcher) scheduleFlushLocked() {
+	if b.timer != nil {
+		return
+	}
+	b.timer = time.AfterFunc(b.window, func() {
+		b.Flush()
+	})
+}
+
+// Flush immediately sends whatever Accept/Decline batches have accumulated,
+// without waiting for the remainder of the batching window to elapse. It
+// returns the first error encountered, if any, but still attempts to send
+// every batch.
+func (b *CallBatcher) Flush() error {
+	b.mu.Lock()
+	accepts := b.accepts
+	declines := b.declines
+	b.accepts = make(map[string]*acceptBatch)
+	b.declines = make(map[string]*declineBatch)
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+	b.mu.Unlock()
+
+	var firstErr error
+	for _, ab := range accepts {
+		if len(ab.operations) == 0 {
+			continue
+		}
+		offerIDs := make([]mesos.OfferID, 0, len(ab.operations))
+		var operations []mesos.Offer_Operation
+		for id, ops := range ab.operations {
+			offerIDs = append(offerIDs, id)
+			operations = append(operations, ops...)
+		}
+		call := &scheduler.Call{
+			Type: scheduler.Call_ACCEPT.Enum(),
+			Accept: &scheduler.Call_Accept{
+				OfferIDs:   offerIDs,
+				Operations: operations,
+			},
+		}
+		if err := b.caller.Call(call); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	for _, db := range declines {
+		if len(db.offerIDs) == 0 {
+			continue
+		}
+		offerIDs := make([]mesos.OfferID, 0, len(db.offerIDs))
+		for id := range db.offerIDs {
+			offerIDs = append(offerIDs, id)
+		}
+		call := &scheduler.Call{
+			Type: scheduler.Call_DECLINE.Enum(),
+			Decline: &scheduler.Call_Decline{
+				OfferIDs: offerIDs,
+				Filters:  db.filters,
+			},
+		}
+		if err := b.caller.Call(call); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// filtersKey returns a string uniquely identifying filters' value, suitable
+// for use as a map key.
+func filtersKey(filters *mesos.Filters) string {
+	if filters == nil || filters.RefuseSeconds == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*filters.RefuseSeconds, 'g', -1, 64)
+}
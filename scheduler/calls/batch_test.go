@@ -0,0 +1,124 @@
+package calls
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mesos/mesos-go"
+	"github.com/mesos/mesos-go/scheduler"
+)
+
+func TestCallBatcherSplitsByAgent(t *testing.T) {
+	var mu sync.Mutex
+	var calls []*scheduler.Call
+	caller := callerFunc(func(c *scheduler.Call) error {
+		mu.Lock()
+		calls = append(calls, c)
+		mu.Unlock()
+		return nil
+	})
+
+	b := NewCallBatcher(caller, time.Hour)
+	agentA := mesos.AgentID{Value: "agentA"}
+	agentB := mesos.AgentID{Value: "agentB"}
+
+	b.Accept(mesos.OfferID{Value: "o1"}, agentA, OpReserve())
+	b.Accept(mesos.OfferID{Value: "o2"}, agentA, OpReserve())
+	b.Accept(mesos.OfferID{Value: "o3"}, agentB, OpReserve())
+
+	if err := b.Flush(); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(calls) != 2 {
+		t.Fatalf("got %d ACCEPT calls, want 2 (one per agent)", len(calls))
+	}
+	for _, c := range calls {
+		ids := make(map[string]bool)
+		for _, id := range c.Accept.OfferIDs {
+			ids[id.Value] = true
+		}
+		if ids["o1"] && ids["o3"] || ids["o2"] && ids["o3"] {
+			t.Fatalf("offers for different agents were merged into one ACCEPT: %v", ids)
+		}
+	}
+}
+
+func TestCallBatcherOfferAppearsOnceAcrossReDecisions(t *testing.T) {
+	var mu sync.Mutex
+	var calls []*scheduler.Call
+	caller := callerFunc(func(c *scheduler.Call) error {
+		mu.Lock()
+		calls = append(calls, c)
+		mu.Unlock()
+		return nil
+	})
+
+	b := NewCallBatcher(caller, time.Hour)
+	agentA := mesos.AgentID{Value: "agentA"}
+	offerID := mesos.OfferID{Value: "o1"}
+
+	b.Accept(offerID, agentA, OpReserve())
+	// The caller changes its mind and declines the same offer before the
+	// window flushes.
+	b.Decline(offerID, nil)
+
+	if err := b.Flush(); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	seen := 0
+	for _, c := range calls {
+		switch *c.Type {
+		case scheduler.Call_ACCEPT:
+			for _, id := range c.Accept.OfferIDs {
+				if id.Value == "o1" {
+					seen++
+				}
+			}
+		case scheduler.Call_DECLINE:
+			for _, id := range c.Decline.OfferIDs {
+				if id.Value == "o1" {
+					seen++
+				}
+			}
+		}
+	}
+	if seen != 1 {
+		t.Fatalf("offer o1 appeared in %d outgoing calls, want 1", seen)
+	}
+}
+
+func TestCallBatcherMergesEquivalentFilters(t *testing.T) {
+	var mu sync.Mutex
+	var calls []*scheduler.Call
+	caller := callerFunc(func(c *scheduler.Call) error {
+		mu.Lock()
+		calls = append(calls, c)
+		mu.Unlock()
+		return nil
+	})
+
+	b := NewCallBatcher(caller, time.Hour)
+	rs := 5.0
+	b.Decline(mesos.OfferID{Value: "o1"}, &mesos.Filters{RefuseSeconds: &rs})
+	b.Decline(mesos.OfferID{Value: "o2"}, &mesos.Filters{RefuseSeconds: &rs})
+
+	if err := b.Flush(); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(calls) != 1 {
+		t.Fatalf("got %d DECLINE calls, want 1 (same Filters value)", len(calls))
+	}
+	if len(calls[0].Decline.OfferIDs) != 2 {
+		t.Fatalf("got %d offer IDs in merged DECLINE, want 2", len(calls[0].Decline.OfferIDs))
+	}
+}
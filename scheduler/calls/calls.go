@@ -15,6 +15,10 @@ func Filters(fo ...mesos.FilterOpt) scheduler.CallOpt {
 			c.Accept.Filters = mesos.OptionalFilters(fo...)
 		case scheduler.Call_DECLINE:
 			c.Decline.Filters = mesos.OptionalFilters(fo...)
+		case scheduler.Call_ACCEPT_INVERSE_OFFERS:
+			c.AcceptInverseOffers.Filters = mesos.OptionalFilters(fo...)
+		case scheduler.Call_DECLINE_INVERSE_OFFERS:
+			c.DeclineInverseOffers.Filters = mesos.OptionalFilters(fo...)
 		default:
 			panic("filters not supported for type " + c.Type.String())
 		}
@@ -31,11 +35,11 @@ func Framework(id string) scheduler.CallOpt {
 // Subscribe returns a subscribe call with the given parameters.
 // The call's FrameworkID is automatically filled in from the info specification.
 func Subscribe(force bool, info *mesos.FrameworkInfo) *scheduler.Call {
-	return &scheduler.Call{
+	return validateIfStrict(&scheduler.Call{
 		Type:        scheduler.Call_SUBSCRIBE.Enum(),
 		FrameworkID: info.GetID(),
 		Subscribe:   &scheduler.Call_Subscribe{FrameworkInfo: info, Force: force},
-	}
+	})
 }
 
 type acceptBuilder struct {
@@ -69,13 +73,13 @@ func Accept(ops ...AcceptOpt) *scheduler.Call {
 	for id := range ab.offerIDs {
 		offerIDs = append(offerIDs, id)
 	}
-	return &scheduler.Call{
+	return validateIfStrict(&scheduler.Call{
 		Type: scheduler.Call_ACCEPT.Enum(),
 		Accept: &scheduler.Call_Accept{
 			OfferIDs:   offerIDs,
 			Operations: ab.operations,
 		},
-	}
+	})
 }
 
 // OpLaunch returns a launch operation builder for the given tasks
@@ -129,60 +133,112 @@ func OpDestroy(rs ...mesos.Resource) OperationBuilder {
 	}
 }
 
+// OpLaunchGroup returns a launch-group operation builder for the given executor and task group.
+func OpLaunchGroup(ei mesos.ExecutorInfo, ti ...mesos.TaskInfo) OperationBuilder {
+	return func() (op mesos.Offer_Operation) {
+		op.Type = mesos.LAUNCH_GROUP.Enum()
+		op.LaunchGroup = &mesos.Offer_Operation_LaunchGroup{
+			Executor: ei,
+			TaskGroup: mesos.TaskGroupInfo{
+				Tasks: ti,
+			},
+		}
+		return
+	}
+}
+
 // Revive returns a revive call.
 // Callers are expected to fill in the FrameworkID.
 func Revive() *scheduler.Call {
-	return &scheduler.Call{
+	return validateIfStrict(&scheduler.Call{
 		Type: scheduler.Call_REVIVE.Enum(),
-	}
+	})
 }
 
 // Decline returns a decline call with the given parameters.
 // Callers are expected to fill in the FrameworkID and Filters.
 func Decline(offerIDs ...mesos.OfferID) *scheduler.Call {
-	return &scheduler.Call{
+	return validateIfStrict(&scheduler.Call{
 		Type: scheduler.Call_DECLINE.Enum(),
 		Decline: &scheduler.Call_Decline{
 			OfferIDs: offerIDs,
 		},
-	}
+	})
+}
+
+// AcceptInverseOffers returns an accept-inverse-offers call with the given parameters.
+// Callers are expected to fill in the FrameworkID and Filters.
+func AcceptInverseOffers(ids ...mesos.OfferID) *scheduler.Call {
+	return validateIfStrict(&scheduler.Call{
+		Type: scheduler.Call_ACCEPT_INVERSE_OFFERS.Enum(),
+		AcceptInverseOffers: &scheduler.Call_AcceptInverseOffers{
+			InverseOfferIDs: ids,
+		},
+	})
+}
+
+// DeclineInverseOffers returns a decline-inverse-offers call with the given parameters.
+// Callers are expected to fill in the FrameworkID and Filters.
+func DeclineInverseOffers(ids ...mesos.OfferID) *scheduler.Call {
+	return validateIfStrict(&scheduler.Call{
+		Type: scheduler.Call_DECLINE_INVERSE_OFFERS.Enum(),
+		DeclineInverseOffers: &scheduler.Call_DeclineInverseOffers{
+			InverseOfferIDs: ids,
+		},
+	})
+}
+
+// Suppress returns a suppress call.
+// Callers are expected to fill in the FrameworkID.
+func Suppress() *scheduler.Call {
+	return validateIfStrict(&scheduler.Call{
+		Type: scheduler.Call_SUPPRESS.Enum(),
+	})
+}
+
+// Teardown returns a teardown call.
+// Callers are expected to fill in the FrameworkID.
+func Teardown() *scheduler.Call {
+	return validateIfStrict(&scheduler.Call{
+		Type: scheduler.Call_TEARDOWN.Enum(),
+	})
 }
 
 // Kill returns a kill call with the given parameters.
 // Callers are expected to fill in the FrameworkID.
 func Kill(taskID, agentID string) *scheduler.Call {
-	return &scheduler.Call{
+	return validateIfStrict(&scheduler.Call{
 		Type: scheduler.Call_KILL.Enum(),
 		Kill: &scheduler.Call_Kill{
 			TaskID:  mesos.TaskID{Value: taskID},
 			AgentID: optionalAgentID(agentID),
 		},
-	}
+	})
 }
 
 // Shutdown returns a shutdown call with the given parameters.
 // Callers are expected to fill in the FrameworkID.
 func Shutdown(executorID, agentID string) *scheduler.Call {
-	return &scheduler.Call{
+	return validateIfStrict(&scheduler.Call{
 		Type: scheduler.Call_SHUTDOWN.Enum(),
 		Shutdown: &scheduler.Call_Shutdown{
 			ExecutorID: mesos.ExecutorID{Value: executorID},
 			AgentID:    mesos.AgentID{Value: agentID},
 		},
-	}
+	})
 }
 
 // Acknowledge returns an acknowledge call with the given parameters.
 // Callers are expected to fill in the FrameworkID.
 func Acknowledge(agentID, taskID string, uuid []byte) *scheduler.Call {
-	return &scheduler.Call{
+	return validateIfStrict(&scheduler.Call{
 		Type: scheduler.Call_ACKNOWLEDGE.Enum(),
 		Acknowledge: &scheduler.Call_Acknowledge{
 			AgentID: mesos.AgentID{Value: agentID},
 			TaskID:  mesos.TaskID{Value: taskID},
 			UUID:    uuid,
 		},
-	}
+	})
 }
 
 // ReconcileTasks constructs a []Call_Reconcile_Task from the given mappings:
@@ -209,34 +265,34 @@ func ReconcileTasks(tasks map[string]string) scheduler.ReconcileOpt {
 // See ReconcileTask.
 // Callers are expected to fill in the FrameworkID.
 func Reconcile(opts ...scheduler.ReconcileOpt) *scheduler.Call {
-	return &scheduler.Call{
+	return validateIfStrict(&scheduler.Call{
 		Type:      scheduler.Call_RECONCILE.Enum(),
 		Reconcile: (&scheduler.Call_Reconcile{}).With(opts...),
-	}
+	})
 }
 
 // Message returns a message call with the given parameters.
 // Callers are expected to fill in the FrameworkID.
 func Message(agentID, executorID string, data []byte) *scheduler.Call {
-	return &scheduler.Call{
+	return validateIfStrict(&scheduler.Call{
 		Type: scheduler.Call_MESSAGE.Enum(),
 		Message: &scheduler.Call_Message{
 			AgentID:    mesos.AgentID{Value: agentID},
 			ExecutorID: mesos.ExecutorID{Value: executorID},
 			Data:       data,
 		},
-	}
+	})
 }
 
 // Request returns a resource request call with the given parameters.
 // Callers are expected to fill in the FrameworkID.
 func Request(requests ...mesos.Request) *scheduler.Call {
-	return &scheduler.Call{
+	return validateIfStrict(&scheduler.Call{
 		Type: scheduler.Call_REQUEST.Enum(),
 		Request: &scheduler.Call_Request{
 			Requests: requests,
 		},
-	}
+	})
 }
 
 func optionalAgentID(agentID string) *mesos.AgentID {
@@ -0,0 +1,184 @@
+// Package retry provides a task retry/backoff policy layer on top of the
+// parent calls package, for frameworks that otherwise hand-roll retry logic
+// around StatusUpdate events. It relaunches failed tasks through a
+// Relauncher and sends RECONCILE calls; it issues no KILL or ACKNOWLEDGE
+// calls.
+package retry
+
+import (
+	"sync"
+	"time"
+
+	"github.com/mesos/mesos-go"
+	"github.com/mesos/mesos-go/scheduler/calls"
+)
+
+// Relauncher re-emits whatever Accept/Launch is appropriate to retry the
+// given task. Implementations typically hold enough of the original
+// TaskInfo to rebuild a launch the next time a suitable offer arrives.
+type Relauncher interface {
+	Relaunch(taskID string) error
+}
+
+// RelauncherFunc is a Relauncher implemented as a plain function.
+type RelauncherFunc func(taskID string) error
+
+// Relaunch implements Relauncher.
+func (f RelauncherFunc) Relaunch(taskID string) error { return f(taskID) }
+
+// TaskState tracks what a Policy needs to know about a single in-flight
+// task.
+type TaskState struct {
+	Retries  int
+	LastSeen time.Time
+}
+
+// StateStore persists TaskState for a Policy. The default, NewInMemoryStore,
+// does not survive a process restart; implement this interface over an
+// external DB to back a persistent scheduler.
+type StateStore interface {
+	Get(taskID string) (TaskState, bool)
+	Set(taskID string, state TaskState)
+	Delete(taskID string)
+	ForEach(func(taskID string, state TaskState))
+}
+
+// InMemoryStore is the default StateStore.
+type InMemoryStore struct {
+	mu     sync.Mutex
+	states map[string]TaskState
+}
+
+// NewInMemoryStore returns an empty, ready-to-use InMemoryStore.
+func NewInMemoryStore() *InMemoryStore {
+	return &InMemoryStore{states: make(map[string]TaskState)}
+}
+
+// Get implements StateStore.
+func (s *InMemoryStore) Get(taskID string) (TaskState, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st, ok := s.states[taskID]
+	return st, ok
+}
+
+// Set implements StateStore.
+func (s *InMemoryStore) Set(taskID string, state TaskState) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.states[taskID] = state
+}
+
+// Delete implements StateStore.
+func (s *InMemoryStore) Delete(taskID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.states, taskID)
+}
+
+// ForEach implements StateStore.
+func (s *InMemoryStore) ForEach(f func(string, TaskState)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, st := range s.states {
+		f(id, st)
+	}
+}
+
+// Policy wraps a calls.Caller and applies a bounded, exponential-backoff
+// retry policy to terminal task failures, plus periodic RECONCILE calls for
+// tasks whose status hasn't been observed recently.
+//
+// The zero value is not ready to use; construct one with NewPolicy.
+type Policy struct {
+	Caller            calls.Caller
+	Relauncher        Relauncher
+	Store             StateStore
+	MaxRetries        int
+	InitialBackoff    time.Duration
+	MaxBackoff        time.Duration
+	ReconcileInterval time.Duration
+}
+
+// NewPolicy returns a Policy for caller and r with reasonable defaults (an
+// InMemoryStore, 3 retries, 1s initial / 1m max backoff, a 30s reconcile
+// interval). Callers may override any field before use.
+func NewPolicy(caller calls.Caller, r Relauncher) *Policy {
+	return &Policy{
+		Caller:            caller,
+		Relauncher:        r,
+		Store:             NewInMemoryStore(),
+		MaxRetries:        3,
+		InitialBackoff:    time.Second,
+		MaxBackoff:        time.Minute,
+		ReconcileInterval: 30 * time.Second,
+	}
+}
+
+// terminalFailure reports whether st is a terminal, non-running failure that
+// the Policy should consider for retry.
+func terminalFailure(st mesos.TaskStatus) bool {
+	switch st.GetState() {
+	case mesos.TASK_FAILED, mesos.TASK_LOST, mesos.TASK_ERROR:
+		return true
+	default:
+		return false
+	}
+}
+
+// HandleUpdate should be invoked by the scheduler's event loop for every
+// TaskStatus it receives. It records that the task was observed and, if the
+// status is a terminal failure within MaxRetries, relaunches it after an
+// exponential backoff; once MaxRetries is exhausted the task is dropped from
+// the Store and left failed.
+func (p *Policy) HandleUpdate(st mesos.TaskStatus) error {
+	taskID := st.TaskID.Value
+	state, _ := p.Store.Get(taskID)
+	state.LastSeen = time.Now()
+
+	if !terminalFailure(st) {
+		if st.GetState() == mesos.TASK_RUNNING {
+			// The task is healthy again; don't let retries accumulated from
+			// an earlier, unrelated failure reduce the budget or inflate the
+			// backoff for whatever fails next.
+			state.Retries = 0
+		}
+		p.Store.Set(taskID, state)
+		return nil
+	}
+
+	if state.Retries >= p.MaxRetries {
+		p.Store.Delete(taskID)
+		return nil
+	}
+
+	backoff := p.InitialBackoff << uint(state.Retries)
+	if p.MaxBackoff > 0 && backoff > p.MaxBackoff {
+		backoff = p.MaxBackoff
+	}
+	state.Retries++
+	p.Store.Set(taskID, state)
+
+	time.AfterFunc(backoff, func() {
+		p.Relauncher.Relaunch(taskID)
+	})
+	return nil
+}
+
+// Reconcile issues a single RECONCILE call, via calls.ReconcileTasks, for
+// every tracked task whose status hasn't been observed within
+// ReconcileInterval. Framework code should call this periodically, e.g. from
+// a time.Ticker.
+func (p *Policy) Reconcile() error {
+	stale := make(map[string]string)
+	cutoff := time.Now().Add(-p.ReconcileInterval)
+	p.Store.ForEach(func(taskID string, state TaskState) {
+		if state.LastSeen.Before(cutoff) {
+			stale[taskID] = ""
+		}
+	})
+	if len(stale) == 0 {
+		return nil
+	}
+	return p.Caller.Call(calls.Reconcile(calls.ReconcileTasks(stale)))
+}
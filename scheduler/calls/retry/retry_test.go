@@ -0,0 +1,93 @@
+package retry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mesos/mesos-go"
+	"github.com/mesos/mesos-go/scheduler"
+)
+
+type nopCaller struct{}
+
+func (nopCaller) Call(*scheduler.Call) error { return nil }
+
+func status(taskID string, state mesos.TaskState) mesos.TaskStatus {
+	return mesos.TaskStatus{
+		TaskID: mesos.TaskID{Value: taskID},
+		State:  state.Enum(),
+	}
+}
+
+func TestHandleUpdateResetsRetriesOnRunning(t *testing.T) {
+	p := NewPolicy(nopCaller{}, RelauncherFunc(func(string) error { return nil }))
+
+	if err := p.HandleUpdate(status("t1", mesos.TASK_FAILED)); err != nil {
+		t.Fatalf("HandleUpdate: %v", err)
+	}
+	st, ok := p.Store.Get("t1")
+	if !ok || st.Retries != 1 {
+		t.Fatalf("got state %+v, want Retries=1", st)
+	}
+
+	if err := p.HandleUpdate(status("t1", mesos.TASK_RUNNING)); err != nil {
+		t.Fatalf("HandleUpdate: %v", err)
+	}
+	st, ok = p.Store.Get("t1")
+	if !ok || st.Retries != 0 {
+		t.Fatalf("got state %+v after TASK_RUNNING, want Retries=0", st)
+	}
+
+	// A later, unrelated failure should start from a fresh retry budget
+	// instead of continuing where the earlier failure left off.
+	if err := p.HandleUpdate(status("t1", mesos.TASK_FAILED)); err != nil {
+		t.Fatalf("HandleUpdate: %v", err)
+	}
+	st, ok = p.Store.Get("t1")
+	if !ok || st.Retries != 1 {
+		t.Fatalf("got state %+v, want Retries=1 after fresh failure", st)
+	}
+}
+
+func TestHandleUpdateDropsTaskAfterMaxRetries(t *testing.T) {
+	p := NewPolicy(nopCaller{}, RelauncherFunc(func(string) error { return nil }))
+	p.MaxRetries = 1
+	p.InitialBackoff = time.Millisecond
+
+	p.HandleUpdate(status("t1", mesos.TASK_FAILED))
+	p.HandleUpdate(status("t1", mesos.TASK_FAILED))
+
+	if _, ok := p.Store.Get("t1"); ok {
+		t.Fatalf("expected task to be dropped from the store after exhausting retries")
+	}
+}
+
+func TestReconcileOnlyCoversStaleTasks(t *testing.T) {
+	var reconciled []string
+	caller := callerFunc(func(c *scheduler.Call) error {
+		for _, task := range c.Reconcile.Tasks {
+			reconciled = append(reconciled, task.TaskID.Value)
+		}
+		return nil
+	})
+
+	p := NewPolicy(caller, RelauncherFunc(func(string) error { return nil }))
+	p.ReconcileInterval = 50 * time.Millisecond
+
+	p.Store.Set("stale", TaskState{LastSeen: time.Now().Add(-time.Hour)})
+	// Stamped right before Reconcile runs, so it's always comfortably
+	// within the interval regardless of scheduling jitter.
+	p.Store.Set("fresh", TaskState{LastSeen: time.Now()})
+
+	if err := p.Reconcile(); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+
+	if len(reconciled) != 1 || reconciled[0] != "stale" {
+		t.Fatalf("got reconciled %v, want [stale]", reconciled)
+	}
+}
+
+type callerFunc func(*scheduler.Call) error
+
+func (f callerFunc) Call(c *scheduler.Call) error { return f(c) }
@@ -0,0 +1,87 @@
+package calls
+
+import (
+	"context"
+	"sync"
+
+	"github.com/mesos/mesos-go/scheduler"
+)
+
+// Caller abstracts the ability to send a scheduler.Call to the master.
+// It is satisfied by the httpcli client used elsewhere in this library.
+type Caller interface {
+	Call(*scheduler.Call) error
+}
+
+// revival is the bookkeeping for a single in-flight REVIVE: done is closed
+// once the revive is resolved (either the Call failed, or Observed was
+// invoked), and err holds the Call's error, if any. err is only written
+// before done is closed, so reading it after <-done is race-free.
+type revival struct {
+	done chan struct{}
+	err  error
+}
+
+// ReviveCoordinator lets framework code issue REVIVE calls from multiple
+// goroutines without flooding the master: concurrent callers of
+// ReviveAndWait that arrive while a revive is already in-flight simply wait
+// on the result of that one, instead of triggering their own.
+//
+// The zero value is ready to use.
+type ReviveCoordinator struct {
+	mu      sync.Mutex
+	pending *revival
+}
+
+// ReviveAndWait sends a REVIVE call via caller, unless one is already
+// in-flight (triggered by a concurrent caller), and blocks until Observed is
+// invoked to signal that the master has acted on it, or until ctx is done.
+// Coalesced callers receive the same error, if any, as the goroutine that
+// actually triggered the Call.
+func (rc *ReviveCoordinator) ReviveAndWait(ctx context.Context, caller Caller) error {
+	rc.mu.Lock()
+	r := rc.pending
+	trigger := r == nil
+	if trigger {
+		r = &revival{done: make(chan struct{})}
+		rc.pending = r
+	}
+	rc.mu.Unlock()
+
+	if trigger {
+		if err := caller.Call(Revive()); err != nil {
+			// If Observed already resolved r while the Call was in flight,
+			// done is closed and the error goes to this goroutine only.
+			rc.mu.Lock()
+			if rc.pending == r {
+				r.err = err
+				rc.pending = nil
+				close(r.done)
+			}
+			rc.mu.Unlock()
+			return err
+		}
+		// The Call succeeded but hasn't taken effect yet: fall through to
+		// the same select every coalesced waiter uses, so the triggering
+		// goroutine also blocks until Observed() (or ctx) resolves it.
+	}
+
+	select {
+	case <-r.done:
+		return r.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Observed unblocks any goroutines currently waiting in ReviveAndWait.
+// Framework code should call this from its event loop upon receipt of the
+// next OFFERS event (or a bounded timeout/heartbeat) following a revive.
+func (rc *ReviveCoordinator) Observed() {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if rc.pending != nil {
+		close(rc.pending.done)
+		rc.pending = nil
+	}
+}
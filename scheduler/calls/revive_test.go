@@ -0,0 +1,105 @@
+package calls
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mesos/mesos-go/scheduler"
+)
+
+type callerFunc func(*scheduler.Call) error
+
+func (f callerFunc) Call(c *scheduler.Call) error { return f(c) }
+
+func TestReviveCoordinatorCoalesces(t *testing.T) {
+	var calls int32
+	var mu sync.Mutex
+	caller := callerFunc(func(c *scheduler.Call) error {
+		mu.Lock()
+		calls++
+		mu.Unlock()
+		return nil
+	})
+
+	var rc ReviveCoordinator
+	const n = 8
+	var wg sync.WaitGroup
+	wg.Add(n)
+	errs := make([]error, n)
+	for i := 0; i < n; i++ {
+		i := i
+		go func() {
+			defer wg.Done()
+			errs[i] = rc.ReviveAndWait(context.Background(), caller)
+		}()
+	}
+
+	// Give every goroutine a chance to coalesce onto the same in-flight
+	// revive before unblocking it.
+	time.Sleep(10 * time.Millisecond)
+	rc.Observed()
+	wg.Wait()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if calls != 1 {
+		t.Fatalf("expected exactly one REVIVE call, got %d", calls)
+	}
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("waiter %d: unexpected error %v", i, err)
+		}
+	}
+}
+
+func TestReviveCoordinatorPropagatesCallError(t *testing.T) {
+	wantErr := errors.New("boom")
+	caller := callerFunc(func(c *scheduler.Call) error { return wantErr })
+
+	var rc ReviveCoordinator
+	const n = 4
+	var wg sync.WaitGroup
+	wg.Add(n)
+	errs := make([]error, n)
+	for i := 0; i < n; i++ {
+		i := i
+		go func() {
+			defer wg.Done()
+			errs[i] = rc.ReviveAndWait(context.Background(), caller)
+		}()
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != wantErr {
+			t.Errorf("waiter %d: got error %v, want %v", i, err, wantErr)
+		}
+	}
+}
+
+func TestReviveCoordinatorContextCanceled(t *testing.T) {
+	block := make(chan struct{})
+	defer close(block)
+	caller := callerFunc(func(c *scheduler.Call) error {
+		<-block
+		return nil
+	})
+
+	var rc ReviveCoordinator
+
+	// Trigger an in-flight revive that won't resolve for the life of the
+	// test; run it in the background so this goroutine can coalesce onto
+	// it and observe ctx cancellation instead.
+	go rc.ReviveAndWait(context.Background(), caller)
+	time.Sleep(10 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := rc.ReviveAndWait(ctx, caller); err != context.DeadlineExceeded {
+		t.Fatalf("got error %v, want %v", err, context.DeadlineExceeded)
+	}
+}
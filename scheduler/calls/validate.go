@@ -0,0 +1,177 @@
+package calls
+
+import (
+	"github.com/mesos/mesos-go"
+	"github.com/mesos/mesos-go/scheduler"
+)
+
+// CallValidator checks a single scheduler.Call_Type for structural
+// correctness, returning an error (always one built via errInvalidCall) if
+// the call violates a Mesos v1 API invariant.
+type CallValidator func(*scheduler.Call) error
+
+// callValidators holds the per-Call_Type invariants enforced by Validate.
+// Call types with no entry here are assumed to have none.
+var callValidators = map[scheduler.Call_Type]CallValidator{
+	scheduler.Call_ACCEPT:                 validateAccept,
+	scheduler.Call_DECLINE:                validateDecline,
+	scheduler.Call_ACCEPT_INVERSE_OFFERS:  validateAcceptInverseOffers,
+	scheduler.Call_DECLINE_INVERSE_OFFERS: validateDeclineInverseOffers,
+	scheduler.Call_KILL:                   validateKill,
+	scheduler.Call_ACKNOWLEDGE:            validateAcknowledge,
+	scheduler.Call_RECONCILE:              validateReconcile,
+}
+
+// Validate reports whether c is well-formed for its Call_Type, e.g. that
+// ACCEPT carries at least one OfferID, KILL a non-empty TaskID, ACKNOWLEDGE a
+// UUID, and RECONCILE only non-empty task ID keys. It's meant to catch
+// malformed calls client-side instead of letting them fail as an opaque HTTP
+// 400 from the master.
+func Validate(c *scheduler.Call) error {
+	if c == nil || c.Type == nil {
+		return errInvalidCall("call and call type are required")
+	}
+	if v, ok := callValidators[*c.Type]; ok {
+		return v(c)
+	}
+	return nil
+}
+
+// Validated returns a CallOpt that runs Validate and panics if it fails.
+// Since CallOpts apply in order, attach it last so it sees the fully-built
+// call, e.g.:
+//
+//	calls.Accept(...).With(calls.Framework(id), calls.Validated())
+func Validated() scheduler.CallOpt {
+	return func(c *scheduler.Call) {
+		if err := Validate(c); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// StrictValidation, when set, causes every top-level call constructor in
+// this package (Accept, Kill, Acknowledge, etc.) to run Validate against the
+// call it just built and panic if it's invalid, so that callers don't have
+// to remember to opt in via Validated() on every call site. It defaults to
+// false to preserve existing behavior; frameworks that want fail-fast
+// construction should set it once at startup.
+var StrictValidation = false
+
+// validateIfStrict runs Validate against c when StrictValidation is set,
+// panicking on failure, and returns c unchanged either way. Top-level call
+// constructors route their return value through this.
+func validateIfStrict(c *scheduler.Call) *scheduler.Call {
+	if StrictValidation {
+		if err := Validate(c); err != nil {
+			panic(err)
+		}
+	}
+	return c
+}
+
+func validateAccept(c *scheduler.Call) error {
+	if c.Accept == nil || len(c.Accept.OfferIDs) == 0 {
+		return errInvalidCall("ACCEPT requires at least one OfferID")
+	}
+	for _, op := range c.Accept.Operations {
+		if err := validateOperation(op); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateOperation checks that op's Type matches whichever single
+// sub-message is actually populated, so that e.g. a Type of RESERVE with a
+// Launch payload (or no payload at all) is rejected rather than silently
+// accepted.
+func validateOperation(op mesos.Offer_Operation) error {
+	set := 0
+	for _, populated := range []bool{
+		op.Launch != nil,
+		op.LaunchGroup != nil,
+		op.Reserve != nil,
+		op.Unreserve != nil,
+		op.Create != nil,
+		op.Destroy != nil,
+	} {
+		if populated {
+			set++
+		}
+	}
+	if set != 1 {
+		return errInvalidCall("operation must set exactly one of Launch/LaunchGroup/Reserve/Unreserve/Create/Destroy")
+	}
+	if op.Type == nil {
+		return errInvalidCall("operation requires a Type")
+	}
+	var ok bool
+	switch *op.Type {
+	case mesos.LAUNCH:
+		ok = op.Launch != nil
+	case mesos.LAUNCH_GROUP:
+		ok = op.LaunchGroup != nil
+	case mesos.RESERVE:
+		ok = op.Reserve != nil
+	case mesos.UNRESERVE:
+		ok = op.Unreserve != nil
+	case mesos.CREATE:
+		ok = op.Create != nil
+	case mesos.DESTROY:
+		ok = op.Destroy != nil
+	default:
+		return errInvalidCall("unrecognized operation type " + op.Type.String())
+	}
+	if !ok {
+		return errInvalidCall(op.Type.String() + " operation's Type doesn't match its populated field")
+	}
+	return nil
+}
+
+func validateDecline(c *scheduler.Call) error {
+	if c.Decline == nil || len(c.Decline.OfferIDs) == 0 {
+		return errInvalidCall("DECLINE requires at least one OfferID")
+	}
+	return nil
+}
+
+func validateAcceptInverseOffers(c *scheduler.Call) error {
+	if c.AcceptInverseOffers == nil || len(c.AcceptInverseOffers.InverseOfferIDs) == 0 {
+		return errInvalidCall("ACCEPT_INVERSE_OFFERS requires at least one InverseOfferID")
+	}
+	return nil
+}
+
+func validateDeclineInverseOffers(c *scheduler.Call) error {
+	if c.DeclineInverseOffers == nil || len(c.DeclineInverseOffers.InverseOfferIDs) == 0 {
+		return errInvalidCall("DECLINE_INVERSE_OFFERS requires at least one InverseOfferID")
+	}
+	return nil
+}
+
+func validateKill(c *scheduler.Call) error {
+	if c.Kill == nil || c.Kill.TaskID.Value == "" {
+		return errInvalidCall("KILL requires a non-empty TaskID")
+	}
+	return nil
+}
+
+func validateAcknowledge(c *scheduler.Call) error {
+	if c.Acknowledge == nil || len(c.Acknowledge.UUID) == 0 {
+		return errInvalidCall("ACKNOWLEDGE requires a UUID")
+	}
+	return nil
+}
+
+func validateReconcile(c *scheduler.Call) error {
+	if c.Reconcile == nil {
+		return nil
+	}
+	for _, t := range c.Reconcile.Tasks {
+		if t.TaskID.Value == "" {
+			return errInvalidCall("RECONCILE task entries require a non-empty TaskID")
+		}
+	}
+	return nil
+}
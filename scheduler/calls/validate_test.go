@@ -0,0 +1,63 @@
+package calls
+
+import (
+	"testing"
+
+	"github.com/mesos/mesos-go"
+	"github.com/mesos/mesos-go/scheduler"
+)
+
+func TestValidateAcceptRejectsMismatchedOperation(t *testing.T) {
+	call := Accept(OfferWithOperations(mesos.OfferID{Value: "o1"}, OpReserve()))
+	// Tamper with the built operation so its Type no longer matches its
+	// populated field, simulating a hand-built, malformed Call.
+	call.Accept.Operations[0].Type = mesos.LAUNCH.Enum()
+
+	if err := Validate(call); err == nil {
+		t.Fatal("expected an error for a Type/payload mismatch, got nil")
+	}
+}
+
+func TestValidateAcceptOKForWellFormedOperation(t *testing.T) {
+	call := Accept(OfferWithOperations(mesos.OfferID{Value: "o1"}, OpReserve()))
+	if err := Validate(call); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestValidateRejectsEmptyAccept(t *testing.T) {
+	call := &scheduler.Call{Type: scheduler.Call_ACCEPT.Enum(), Accept: &scheduler.Call_Accept{}}
+	if err := Validate(call); err == nil {
+		t.Fatal("expected an error for ACCEPT with no OfferIDs, got nil")
+	}
+}
+
+func TestValidatedPanicsOnInvalidCall(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected Validated() to panic on an invalid call")
+		}
+	}()
+	call := Kill("", "")
+	call.With(Validated())
+}
+
+func TestStrictValidationPanicsAtConstruction(t *testing.T) {
+	StrictValidation = true
+	defer func() { StrictValidation = false }()
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected Kill(\"\", \"\") to panic under StrictValidation")
+		}
+	}()
+	Kill("", "")
+}
+
+func TestStrictValidationOffByDefault(t *testing.T) {
+	if StrictValidation {
+		t.Fatal("StrictValidation must default to false")
+	}
+	// Must not panic.
+	Kill("", "")
+}